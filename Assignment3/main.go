@@ -1,107 +0,0 @@
-package main
-
-import (
-	"context"
-	"fmt"
-	"log"
-	"net/http"
-	"os"
-	"time"
-
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
-	"go.mongodb.org/mongo-driver/mongo/readpref"
-)
-
-type Product struct {
-	Name  string  `json:"name"`
-	Price float64 `json:"price"`
-}
-
-var client *mongo.Client
-var productCollection *mongo.Collection
-
-func main() {
-	// Load environment variables
-	loadEnv()
-
-	// Connect to MongoDB
-	clientOptions := options.Client().ApplyURI(os.Getenv("MONGO_URI"))
-	var err error
-	client, err = mongo.Connect(context.TODO(), clientOptions)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// Check the connection
-	err = client.Ping(context.TODO(), readpref.Primary())
-	if err != nil {
-		log.Fatal(err)
-	}
-	fmt.Println("Connected to MongoDB!")
-
-	// Get the product collection
-	productCollection = client.Database("marketplace").Collection("products")
-
-	// Set up Gin
-	router := gin.Default()
-
-	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:3000"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true,
-		MaxAge:           12 * time.Hour,
-	}))
-
-	router.GET("/products", getProducts)
-	router.POST("/products", createProduct)
-
-	router.Run("localhost:8080")
-}
-
-func loadEnv() {
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatal("Error loading .env file")
-	}
-}
-
-func getProducts(c *gin.Context) {
-	var products []Product
-	cursor, err := productCollection.Find(context.Background(), bson.D{})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	defer cursor.Close(context.Background())
-
-	for cursor.Next(context.Background()) {
-		var product Product
-		cursor.Decode(&product)
-		products = append(products, product)
-	}
-
-	c.JSON(http.StatusOK, products)
-}
-
-func createProduct(c *gin.Context) {
-	var product Product
-	if err := c.BindJSON(&product); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	_, err := productCollection.InsertOne(context.Background(), product)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	c.JSON(http.StatusOK, product)
-}
@@ -0,0 +1,36 @@
+// Command invoice is the standalone receipt-rendering service. It exposes
+// POST /invoices and is called by the transaction service after a
+// transaction is created.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/invoice"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/middleware"
+)
+
+func main() {
+	loadEnv()
+
+	router := gin.Default()
+	router.Use(middleware.RequestID(), middleware.Logger(), middleware.CORS())
+
+	invoice.NewHandler().Register(router)
+
+	addr := os.Getenv("INVOICE_ADDR")
+	if addr == "" {
+		addr = "localhost:8082"
+	}
+	router.Run(addr)
+}
+
+func loadEnv() {
+	if err := godotenv.Load(); err != nil {
+		log.Fatal("Error loading .env file")
+	}
+}
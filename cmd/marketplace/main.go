@@ -0,0 +1,221 @@
+// Command marketplace wires the product, transaction, user and bills
+// services onto a single Gin router. Set SERVICES to a comma-separated
+// subset of "product,transaction,user,bills" to run only part of the API
+// (e.g. as separate deployments sharing the same Mongo database); it
+// defaults to all four.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/bills"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/db"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/invoiceclient"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/lightning"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/middleware"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/payment"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/shop"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/shop/amazon"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/shop/opengraph"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/shop/steam"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/transaction"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/user"
+)
+
+func main() {
+	loadEnv()
+
+	if _, err := db.Connect(); err != nil {
+		log.Fatal(err)
+	}
+	defer db.Disconnect()
+
+	router := gin.Default()
+	router.Use(middleware.RequestID(), middleware.Logger(), middleware.CORS())
+
+	services := enabledServices()
+	tokens := user.NewTokenService(jwtSecret())
+
+	if services["user"] {
+		userRepo := user.NewRepository(db.Collection("users"), db.Collection("refresh_tokens"))
+		user.NewHandler(userRepo, tokens).Register(router)
+	}
+
+	if services["product"] {
+		productHandler := product.NewHandler(product.NewRepository(db.Collection("products")), newShopManager(), tokens)
+		productHandler.Register(router)
+	}
+
+	transactionRepo := transaction.NewRepository(db.Collection("transactions"))
+
+	if services["transaction"] {
+		invoiceServiceURL := os.Getenv("INVOICE_SERVICE_URL")
+		if invoiceServiceURL == "" {
+			invoiceServiceURL = "http://localhost:8082"
+		}
+
+		receiptDir := os.Getenv("RECEIPTS_DIR")
+		if receiptDir == "" {
+			receiptDir = "invoices"
+		}
+
+		fallback := invoiceclient.NewFailureQueue(db.Collection("pending_invoice_renders"))
+		invoices := invoiceclient.NewClient(invoiceServiceURL, fallback)
+
+		lightningClient := newLightningClient()
+		if lightningClient != nil {
+			go subscribeLightningSettlements(lightningClient, transactionRepo)
+		}
+
+		transactionHandler := transaction.NewHandler(transactionRepo, invoices, receiptDir, lightningClient, tokens)
+		transactionHandler.Register(router)
+
+		go drainFailedRenders(fallback, invoices, transactionHandler)
+
+		payment.NewHandler().Register(router)
+	}
+
+	if services["bills"] {
+		bills.NewHandler(newBillRegistry(), transactionRepo, tokens).Register(router)
+	}
+
+	addr := os.Getenv("MARKETPLACE_ADDR")
+	if addr == "" {
+		addr = "localhost:8080"
+	}
+	router.Run(addr)
+}
+
+// drainFailedRenders periodically retries receipts that exhausted their
+// inline retries, so a temporary invoice-service outage self-heals instead
+// of leaving a transaction without a receipt forever. Recovered renders are
+// saved through receipts the same way the happy path does, so
+// GET /transactions/:id/receipt picks them up.
+func drainFailedRenders(fallback *invoiceclient.FailureQueue, invoices *invoiceclient.Client, receipts *transaction.Handler) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		fallback.Drain(context.Background(), invoices.Render, receipts.SaveReceipt)
+	}
+}
+
+// newLightningClient builds a lightning.Client from LND_ADDR, LND_TLS_CERT_PATH
+// and LND_MACAROON_HEX. It returns nil when LND_ADDR is unset, which leaves
+// lightning payments disabled.
+func newLightningClient() *lightning.Client {
+	addr := os.Getenv("LND_ADDR")
+	if addr == "" {
+		return nil
+	}
+
+	lnd, _, err := lightning.Dial(addr, os.Getenv("LND_TLS_CERT_PATH"), os.Getenv("LND_MACAROON_HEX"))
+	if err != nil {
+		log.Printf("lightning payments disabled: %v", err)
+		return nil
+	}
+
+	return lightning.NewClient(lnd, priceFeedFromEnv())
+}
+
+// newShopManager builds the shop.Manager used by POST /products/import,
+// with every known adapter registered and a generic OpenGraph scraper as
+// the fallback for domains without a dedicated one.
+func newShopManager() *shop.Manager {
+	manager := shop.NewManager(time.Second, 10*time.Minute)
+	manager.Register(amazon.New())
+	manager.Register(steam.New())
+	manager.SetFallback(opengraph.New())
+	return manager
+}
+
+func priceFeedFromEnv() lightning.PriceFeed {
+	if url := os.Getenv("PRICE_FEED_URL"); url != "" {
+		return lightning.NewHTTPPriceFeed(url)
+	}
+	return lightning.StaticPriceFeed{Rate: 2000}
+}
+
+// subscribeLightningSettlements marks a transaction paid as soon as its
+// lightning invoice settles, the same way pay marks a card transaction paid.
+// The subscription is re-established with a backoff whenever it drops (node
+// restart, network blip) instead of leaving settlements untracked for the
+// rest of the process's life.
+func subscribeLightningSettlements(lnd *lightning.Client, repo *transaction.Repository) {
+	const minBackoff = time.Second
+	const maxBackoff = time.Minute
+	backoff := minBackoff
+
+	for {
+		connectedAt := time.Now()
+		err := lnd.Subscribe(context.Background(), func(hash string, _ time.Time) {
+			if _, err := repo.SettleByPaymentHash(context.Background(), hash); err != nil {
+				log.Printf("failed to mark transaction paid for invoice %s: %v", hash, err)
+			}
+		})
+		log.Printf("lightning settlement subscription ended, reconnecting in %s: %v", backoff, err)
+
+		if time.Since(connectedAt) > maxBackoff {
+			backoff = minBackoff
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// newBillRegistry builds the bills.Registry used by the bill-payment
+// routes. Only a mock electricity vendor is wired up for now; real vendors
+// register here as they're integrated.
+func newBillRegistry() *bills.Registry {
+	registry := bills.NewRegistry()
+	registry.Register(bills.NewMockProvider(
+		bills.Vendor{ID: "mock-electricity", Name: "Mock Electricity Co.", Category: "electricity"},
+		[]bills.Product{
+			{ID: "prepaid", VendorID: "mock-electricity", Name: "Prepaid top-up", MinAmount: 5, MaxAmount: 500},
+		},
+	))
+	return registry
+}
+
+func enabledServices() map[string]bool {
+	all := map[string]bool{"product": true, "transaction": true, "user": true, "bills": true}
+
+	raw := os.Getenv("SERVICES")
+	if raw == "" {
+		return all
+	}
+
+	enabled := make(map[string]bool, 4)
+	for _, name := range strings.Split(raw, ",") {
+		enabled[strings.TrimSpace(name)] = true
+	}
+	return enabled
+}
+
+// jwtSecret reads the signing secret for access tokens from JWT_SECRET. It
+// must be set to the same value across every instance of the marketplace
+// binary so a token issued by one is accepted by another.
+func jwtSecret() string {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		log.Fatal("JWT_SECRET must be set")
+	}
+	return secret
+}
+
+func loadEnv() {
+	if err := godotenv.Load(); err != nil {
+		log.Fatal("Error loading .env file")
+	}
+}
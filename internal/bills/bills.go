@@ -0,0 +1,131 @@
+// Package bills lets a customer pay a utility or airtime bill through the
+// marketplace. Vendors are pluggable behind the BillProvider interface so a
+// mock vendor can stand in locally while real ones, each with their own
+// auth and request signing, are added over time.
+package bills
+
+import (
+	"context"
+	"fmt"
+)
+
+// Vendor is a third-party biller a customer can pay through the
+// marketplace, e.g. a specific electricity company.
+type Vendor struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// Product is one of a vendor's payable products, e.g. a specific airtime
+// top-up tier. Fixed products set FixedAmount and leave MinAmount/MaxAmount
+// zero; ranged products are the reverse.
+type Product struct {
+	ID          string  `json:"id"`
+	VendorID    string  `json:"vendorId"`
+	Name        string  `json:"name"`
+	FixedAmount float64 `json:"fixedAmount,omitempty"`
+	MinAmount   float64 `json:"minAmount,omitempty"`
+	MaxAmount   float64 `json:"maxAmount,omitempty"`
+}
+
+// ValidateAmount reports whether amount is payable for p: exactly
+// FixedAmount for a fixed-tier product, or within [MinAmount, MaxAmount]
+// for a ranged one.
+func (p Product) ValidateAmount(amount float64) error {
+	if p.FixedAmount != 0 {
+		if amount != p.FixedAmount {
+			return fmt.Errorf("product %s is a fixed %.2f payment, got %.2f", p.ID, p.FixedAmount, amount)
+		}
+		return nil
+	}
+	if amount < p.MinAmount || amount > p.MaxAmount {
+		return fmt.Errorf("product %s accepts %.2f-%.2f, got %.2f", p.ID, p.MinAmount, p.MaxAmount, amount)
+	}
+	return nil
+}
+
+// PaymentRequest is what a BillProvider needs to pay one of its products.
+type PaymentRequest struct {
+	ProductID   string
+	CustomerRef string
+	Amount      float64
+}
+
+// PaymentResult is what a BillProvider returns once a payment is accepted.
+type PaymentResult struct {
+	// VendorReference is the vendor's own identifier for the payment, kept
+	// on the transaction for support/reconciliation.
+	VendorReference string
+}
+
+// BillProvider integrates with a single vendor's remote API.
+type BillProvider interface {
+	Vendor() Vendor
+	Products(ctx context.Context) ([]Product, error)
+	Pay(ctx context.Context, req PaymentRequest) (PaymentResult, error)
+}
+
+// Registry dispatches bill lookups and payments to the BillProvider
+// registered for a vendor ID.
+type Registry struct {
+	providers map[string]BillProvider
+}
+
+// NewRegistry builds an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[string]BillProvider)}
+}
+
+// Register adds provider under its own vendor ID, overwriting any provider
+// already registered for that ID. It should be called once at startup for
+// each known vendor.
+func (r *Registry) Register(provider BillProvider) {
+	r.providers[provider.Vendor().ID] = provider
+}
+
+// Vendors lists every registered vendor, optionally filtered to one
+// category.
+func (r *Registry) Vendors(category string) []Vendor {
+	vendors := make([]Vendor, 0, len(r.providers))
+	for _, provider := range r.providers {
+		v := provider.Vendor()
+		if category != "" && v.Category != category {
+			continue
+		}
+		vendors = append(vendors, v)
+	}
+	return vendors
+}
+
+// Products lists vendorID's payable products.
+func (r *Registry) Products(ctx context.Context, vendorID string) ([]Product, error) {
+	provider, ok := r.providers[vendorID]
+	if !ok {
+		return nil, fmt.Errorf("no bill provider registered for vendor %s", vendorID)
+	}
+	return provider.Products(ctx)
+}
+
+// Product looks up a single product of vendorID's by ID.
+func (r *Registry) Product(ctx context.Context, vendorID, productID string) (Product, error) {
+	products, err := r.Products(ctx, vendorID)
+	if err != nil {
+		return Product{}, err
+	}
+	for _, p := range products {
+		if p.ID == productID {
+			return p, nil
+		}
+	}
+	return Product{}, fmt.Errorf("vendor %s has no product %s", vendorID, productID)
+}
+
+// Pay routes req to vendorID's provider.
+func (r *Registry) Pay(ctx context.Context, vendorID string, req PaymentRequest) (PaymentResult, error) {
+	provider, ok := r.providers[vendorID]
+	if !ok {
+		return PaymentResult{}, fmt.Errorf("no bill provider registered for vendor %s", vendorID)
+	}
+	return provider.Pay(ctx, req)
+}
@@ -0,0 +1,98 @@
+package bills
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProductValidateAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		product Product
+		amount  float64
+		wantErr bool
+	}{
+		{
+			name:    "fixed tier exact match",
+			product: Product{ID: "p1", FixedAmount: 10},
+			amount:  10,
+		},
+		{
+			name:    "fixed tier mismatch",
+			product: Product{ID: "p1", FixedAmount: 10},
+			amount:  11,
+			wantErr: true,
+		},
+		{
+			name:    "ranged within bounds",
+			product: Product{ID: "p2", MinAmount: 5, MaxAmount: 50},
+			amount:  25,
+		},
+		{
+			name:    "ranged at lower bound",
+			product: Product{ID: "p2", MinAmount: 5, MaxAmount: 50},
+			amount:  5,
+		},
+		{
+			name:    "ranged at upper bound",
+			product: Product{ID: "p2", MinAmount: 5, MaxAmount: 50},
+			amount:  50,
+		},
+		{
+			name:    "ranged below minimum",
+			product: Product{ID: "p2", MinAmount: 5, MaxAmount: 50},
+			amount:  4.99,
+			wantErr: true,
+		},
+		{
+			name:    "ranged above maximum",
+			product: Product{ID: "p2", MinAmount: 5, MaxAmount: 50},
+			amount:  50.01,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.product.ValidateAmount(tt.amount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateAmount(%v) error = %v, wantErr %v", tt.amount, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistryMockProviderRoundTrip(t *testing.T) {
+	vendor := Vendor{ID: "mock-electricity", Name: "Mock Electricity Co.", Category: "electricity"}
+	product := Product{ID: "prepaid", VendorID: vendor.ID, Name: "Prepaid top-up", MinAmount: 5, MaxAmount: 500}
+
+	registry := NewRegistry()
+	registry.Register(NewMockProvider(vendor, []Product{product}))
+
+	ctx := context.Background()
+
+	vendors := registry.Vendors("")
+	if len(vendors) != 1 || vendors[0].ID != vendor.ID {
+		t.Fatalf("Vendors() = %v, want [%v]", vendors, vendor)
+	}
+
+	got, err := registry.Product(ctx, vendor.ID, product.ID)
+	if err != nil {
+		t.Fatalf("Product() error = %v", err)
+	}
+	if got != product {
+		t.Fatalf("Product() = %v, want %v", got, product)
+	}
+
+	if _, err := registry.Product(ctx, vendor.ID, "does-not-exist"); err == nil {
+		t.Fatal("Product() with unknown ID: want error, got nil")
+	}
+
+	result, err := registry.Pay(ctx, vendor.ID, PaymentRequest{ProductID: product.ID, CustomerRef: "acct-1", Amount: 20})
+	if err != nil {
+		t.Fatalf("Pay() error = %v", err)
+	}
+	if result.VendorReference == "" {
+		t.Fatal("Pay() returned an empty VendorReference")
+	}
+}
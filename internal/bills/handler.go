@@ -0,0 +1,111 @@
+package bills
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/transaction"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/user"
+)
+
+// Handler exposes the bill-payment endpoints on top of a Registry, stamping
+// a transaction.Transaction of type "bill" for every payment.
+type Handler struct {
+	registry     *Registry
+	transactions *transaction.Repository
+	tokens       *user.TokenService
+}
+
+// NewHandler builds a Handler backed by registry. Payments are recorded on
+// transactions, and POST /bills/pay is authenticated through tokens.
+func NewHandler(registry *Registry, transactions *transaction.Repository, tokens *user.TokenService) *Handler {
+	return &Handler{registry: registry, transactions: transactions, tokens: tokens}
+}
+
+// Register mounts the bill-payment routes on router.
+func (h *Handler) Register(router gin.IRouter) {
+	router.GET("/bills/vendors", h.listVendors)
+	router.GET("/bills/vendors/:id/products", h.listProducts)
+	router.POST("/bills/pay", user.Auth(h.tokens), h.pay)
+}
+
+func (h *Handler) listVendors(c *gin.Context) {
+	c.JSON(http.StatusOK, h.registry.Vendors(c.Query("category")))
+}
+
+func (h *Handler) listProducts(c *gin.Context) {
+	products, err := h.registry.Products(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, products)
+}
+
+func (h *Handler) pay(c *gin.Context) {
+	var body struct {
+		VendorID    string  `json:"vendorId" binding:"required"`
+		ProductID   string  `json:"productId" binding:"required"`
+		CustomerRef string  `json:"customerRef" binding:"required"`
+		Amount      float64 `json:"amount" binding:"required"`
+	}
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := h.registry.Product(c.Request.Context(), body.VendorID, body.ProductID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err := product.ValidateAmount(body.Amount); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	id, name, email := user.Identity(c)
+	t := transaction.Transaction{
+		Type:       "bill",
+		Customer:   transaction.Customer{ID: id, Name: name, Email: email},
+		Status:     "processing",
+		TotalPrice: body.Amount,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		BillDetails: &transaction.BillDetails{
+			VendorID:    body.VendorID,
+			ProductID:   body.ProductID,
+			CustomerRef: body.CustomerRef,
+		},
+	}
+
+	txID, err := h.transactions.Insert(c.Request.Context(), t)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.registry.Pay(c.Request.Context(), body.VendorID, PaymentRequest{
+		ProductID:   body.ProductID,
+		CustomerRef: body.CustomerRef,
+		Amount:      body.Amount,
+	})
+	if err != nil {
+		if _, markErr := h.transactions.MarkFailed(c.Request.Context(), txID); markErr != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": markErr.Error()})
+			return
+		}
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated, err := h.transactions.CompleteBill(c.Request.Context(), txID, result.VendorReference)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transaction": updated})
+}
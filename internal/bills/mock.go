@@ -0,0 +1,38 @@
+package bills
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// MockProvider is a BillProvider that accepts every payment without
+// calling out to a real vendor, for local development and tests.
+type MockProvider struct {
+	vendor   Vendor
+	products []Product
+}
+
+// NewMockProvider builds a MockProvider for vendor offering products.
+func NewMockProvider(vendor Vendor, products []Product) *MockProvider {
+	return &MockProvider{vendor: vendor, products: products}
+}
+
+// Vendor returns the mock vendor's details.
+func (p *MockProvider) Vendor() Vendor {
+	return p.vendor
+}
+
+// Products returns the mock vendor's fixed product list.
+func (p *MockProvider) Products(ctx context.Context) ([]Product, error) {
+	return p.products, nil
+}
+
+// Pay always succeeds, returning a random vendor reference.
+func (p *MockProvider) Pay(ctx context.Context, req PaymentRequest) (PaymentResult, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return PaymentResult{}, err
+	}
+	return PaymentResult{VendorReference: "mock-" + hex.EncodeToString(buf)}, nil
+}
@@ -0,0 +1,59 @@
+// Package db manages the single shared MongoDB client used by every
+// service in the marketplace binary.
+package db
+
+import (
+	"context"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+var (
+	client *mongo.Client
+	once   sync.Once
+)
+
+// Connect establishes the shared Mongo client on first call and returns the
+// same client on subsequent calls. It reads the connection string from
+// MONGO_URI and pings the primary to verify connectivity.
+func Connect() (*mongo.Client, error) {
+	var err error
+	once.Do(func() {
+		clientOptions := options.Client().ApplyURI(os.Getenv("MONGO_URI"))
+		client, err = mongo.Connect(context.Background(), clientOptions)
+		if err != nil {
+			return
+		}
+		if err = client.Ping(context.Background(), readpref.Primary()); err != nil {
+			return
+		}
+		log.Println("Connected to MongoDB!")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// Collection returns a handle to a collection in the "marketplace" database.
+// Connect must have succeeded first.
+func Collection(name string) *mongo.Collection {
+	return client.Database("marketplace").Collection(name)
+}
+
+// Disconnect closes the shared client, giving in-flight operations up to 5
+// seconds to finish.
+func Disconnect() error {
+	if client == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return client.Disconnect(ctx)
+}
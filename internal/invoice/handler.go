@@ -0,0 +1,35 @@
+package invoice
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler exposes the receipt-rendering endpoint used by the standalone
+// invoice service.
+type Handler struct{}
+
+// NewHandler builds a Handler.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// Register mounts the invoice routes on router.
+func (h *Handler) Register(router gin.IRouter) {
+	router.POST("/invoices", h.render)
+}
+
+func (h *Handler) render(c *gin.Context) {
+	var req Request
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "application/pdf")
+	if err := Generate(c.Writer, req.CartItems, req.TotalPrice); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+}
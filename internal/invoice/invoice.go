@@ -0,0 +1,65 @@
+// Package invoice renders the fiscal receipt PDF for a transaction.
+package invoice
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jung-kurt/gofpdf/v2"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+)
+
+// Generate renders a fiscal receipt for items/totalPrice to w.
+func Generate(w io.Writer, items []product.Product, totalPrice float64) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+
+	pdf.AddPage()
+
+	pdf.SetFont("Arial", "B", 16)
+
+	pdf.Cell(0, 10, "START OF FISCAL RECEIPT")
+	pdf.Ln(12)
+
+	pdf.SetFont("Arial", "", 12)
+
+	pdf.Cell(0, 10, "Maximus & Kuka Ltd")
+	pdf.Ln(8)
+	pdf.Cell(0, 10, "TIN: 098908978")
+	pdf.Ln(8)
+	pdf.Cell(0, 10, "Welcome to our shop!")
+	pdf.Ln(12)
+
+	pdf.Line(10, pdf.GetY(), 200, pdf.GetY())
+	pdf.Ln(5)
+
+	for _, item := range items {
+		pdf.CellFormat(100, 10, item.Name, "0", 0, "L", false, 0, "")
+		pdf.CellFormat(40, 10, fmt.Sprintf("%.2f", item.Price), "0", 0, "L", false, 0, "")
+		pdf.Ln(8)
+	}
+
+	pdf.Ln(5)
+
+	pdf.Line(10, pdf.GetY(), 200, pdf.GetY())
+
+	pdf.Cell(0, 10, "NUMBER OF ITEMS")
+	pdf.CellFormat(0, 10, fmt.Sprintf("%d", len(items)), "0", 1, "R", false, 0, "")
+
+	pdf.Cell(0, 10, "TOTAL")
+	pdf.CellFormat(0, 10, fmt.Sprintf("%.2f", totalPrice), "0", 1, "R", false, 0, "")
+
+	pdf.Cell(0, 10, "CARD")
+	pdf.CellFormat(0, 10, fmt.Sprintf("%.2f", totalPrice), "0", 1, "R", false, 0, "")
+
+	pdf.Line(10, pdf.GetY(), 200, pdf.GetY())
+	pdf.Ln(5)
+
+	pdf.CellFormat(0, 10, "THANK YOU", "", 1, "C", false, 0, "")
+	pdf.CellFormat(0, 10, "COME BACK AGAIN", "", 1, "C", false, 0, "")
+
+	pdf.Line(10, pdf.GetY(), 200, pdf.GetY())
+	pdf.Ln(5)
+
+	return pdf.Output(w)
+}
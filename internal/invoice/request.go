@@ -0,0 +1,18 @@
+package invoice
+
+import "github.com/kfcboxmaster/GolangMarketplace/internal/product"
+
+// Customer identifies who a receipt is for.
+type Customer struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Request is the payload the invoice service accepts at POST /invoices.
+type Request struct {
+	TransactionID string            `json:"transactionId"`
+	CartItems     []product.Product `json:"cartItems"`
+	Customer      Customer          `json:"customer"`
+	TotalPrice    float64           `json:"totalPrice"`
+}
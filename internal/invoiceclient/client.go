@@ -0,0 +1,92 @@
+// Package invoiceclient calls the standalone invoice service over HTTP and
+// queues renders that fail for later retry.
+package invoiceclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/invoice"
+)
+
+// Client renders receipts through the invoice service.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	maxRetries int
+	backoff    time.Duration
+	fallback   *FailureQueue
+}
+
+// NewClient builds a Client pointed at the invoice service's base URL. If
+// fallback is non-nil, renders that exhaust their retries are queued on it
+// instead of being dropped.
+func NewClient(baseURL string, fallback *FailureQueue) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		maxRetries: 3,
+		backoff:    200 * time.Millisecond,
+		fallback:   fallback,
+	}
+}
+
+// Render asks the invoice service to render req and returns the PDF bytes,
+// retrying with exponential backoff before queueing it on the fallback.
+func (c *Client) Render(ctx context.Context, req invoice.Request) ([]byte, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	backoff := c.backoff
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			backoff *= 2
+		}
+
+		pdf, err := c.doRender(ctx, body)
+		if err == nil {
+			return pdf, nil
+		}
+		lastErr = err
+	}
+
+	if c.fallback != nil {
+		if qerr := c.fallback.Enqueue(ctx, req); qerr != nil {
+			log.Printf("invoiceclient: failed to queue render for transaction %s: %v", req.TransactionID, qerr)
+		}
+	}
+	return nil, fmt.Errorf("invoice service unavailable after %d attempts: %w", c.maxRetries+1, lastErr)
+}
+
+func (c *Client) doRender(ctx context.Context, body []byte) ([]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/invoices", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invoice service returned %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
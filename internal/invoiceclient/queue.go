@@ -0,0 +1,69 @@
+package invoiceclient
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/invoice"
+)
+
+// pendingRender is a receipt render that exhausted its retries and is
+// waiting for Drain to try it again.
+type pendingRender struct {
+	TransactionID string          `bson:"_id"`
+	Request       invoice.Request `bson:"request"`
+	QueuedAt      time.Time       `bson:"queuedAt"`
+}
+
+// FailureQueue persists renders that Client.Render couldn't deliver so a
+// background worker can retry them later.
+type FailureQueue struct {
+	collection *mongo.Collection
+}
+
+// NewFailureQueue builds a FailureQueue around the given collection.
+func NewFailureQueue(collection *mongo.Collection) *FailureQueue {
+	return &FailureQueue{collection: collection}
+}
+
+// Enqueue records req for a later retry, replacing any existing entry for
+// the same transaction.
+func (q *FailureQueue) Enqueue(ctx context.Context, req invoice.Request) error {
+	_, err := q.collection.ReplaceOne(ctx,
+		bson.M{"_id": req.TransactionID},
+		pendingRender{TransactionID: req.TransactionID, Request: req, QueuedAt: time.Now()},
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// Drain retries every queued render once via render, handing the rendered
+// PDF to save and removing the entry from the queue on success. save is the
+// same disk write transaction.Handler.renderReceipt does on the happy path,
+// so a recovered render ends up in the same place GET .../receipt expects.
+func (q *FailureQueue) Drain(ctx context.Context, render func(context.Context, invoice.Request) ([]byte, error), save func(transactionID string, pdf []byte) error) {
+	cursor, err := q.collection.Find(ctx, bson.D{})
+	if err != nil {
+		return
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var pending pendingRender
+		if err := cursor.Decode(&pending); err != nil {
+			continue
+		}
+		pdf, err := render(ctx, pending.Request)
+		if err != nil {
+			continue
+		}
+		if err := save(pending.TransactionID, pdf); err != nil {
+			continue
+		}
+		q.collection.DeleteOne(ctx, bson.M{"_id": pending.TransactionID})
+	}
+}
@@ -0,0 +1,76 @@
+// Package lightning creates and watches BOLT11 invoices against an LND
+// node so the transaction service can accept Lightning Network payments.
+package lightning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// PriceFeed converts a fiat total price into satoshis.
+type PriceFeed interface {
+	SatsPerUnit(ctx context.Context) (float64, error)
+}
+
+// Invoice is the BOLT11 invoice handed back to the client.
+type Invoice struct {
+	PaymentHash    string
+	PaymentRequest string
+}
+
+// Client creates and watches invoices on top of an LND gRPC connection.
+type Client struct {
+	lnd       lnrpc.LightningClient
+	priceFeed PriceFeed
+}
+
+// NewClient builds a Client around an existing LND client and price feed.
+func NewClient(lnd lnrpc.LightningClient, priceFeed PriceFeed) *Client {
+	return &Client{lnd: lnd, priceFeed: priceFeed}
+}
+
+// CreateInvoice asks the LND node for a BOLT11 invoice worth totalPrice,
+// converted to satoshis through the configured price feed.
+func (c *Client) CreateInvoice(ctx context.Context, totalPrice float64, memo string) (Invoice, error) {
+	rate, err := c.priceFeed.SatsPerUnit(ctx)
+	if err != nil {
+		return Invoice{}, fmt.Errorf("fetching price feed: %w", err)
+	}
+
+	resp, err := c.lnd.AddInvoice(ctx, &lnrpc.Invoice{
+		Memo:  memo,
+		Value: int64(totalPrice * rate),
+	})
+	if err != nil {
+		return Invoice{}, fmt.Errorf("creating lightning invoice: %w", err)
+	}
+
+	return Invoice{
+		PaymentHash:    fmt.Sprintf("%x", resp.RHash),
+		PaymentRequest: resp.PaymentRequest,
+	}, nil
+}
+
+// Subscribe streams settlement notifications from the LND node, calling
+// onSettled whenever an invoice transitions to the settled state. It blocks
+// until ctx is cancelled or the stream errors.
+func (c *Client) Subscribe(ctx context.Context, onSettled func(paymentHash string, settledAt time.Time)) error {
+	stream, err := c.lnd.SubscribeInvoices(ctx, &lnrpc.InvoiceSubscription{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		inv, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if inv.State != lnrpc.Invoice_SETTLED {
+			continue
+		}
+		onSettled(fmt.Sprintf("%x", inv.RHash), time.Unix(inv.SettleDate, 0))
+	}
+}
@@ -0,0 +1,58 @@
+package lightning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// StaticPriceFeed returns a fixed sats-per-unit rate. Useful for local
+// development or a deployment pinned to a single stable rate.
+type StaticPriceFeed struct {
+	Rate float64
+}
+
+// SatsPerUnit implements PriceFeed.
+func (f StaticPriceFeed) SatsPerUnit(ctx context.Context) (float64, error) {
+	return f.Rate, nil
+}
+
+// HTTPPriceFeed fetches the current sats-per-unit rate from a configurable
+// JSON endpoint returning {"satsPerUnit": <float>}.
+type HTTPPriceFeed struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewHTTPPriceFeed builds an HTTPPriceFeed pointed at url.
+func NewHTTPPriceFeed(url string) *HTTPPriceFeed {
+	return &HTTPPriceFeed{url: url, httpClient: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// SatsPerUnit implements PriceFeed.
+func (f *HTTPPriceFeed) SatsPerUnit(ctx context.Context) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("price feed returned %d", resp.StatusCode)
+	}
+
+	var payload struct {
+		SatsPerUnit float64 `json:"satsPerUnit"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return 0, err
+	}
+	return payload.SatsPerUnit, nil
+}
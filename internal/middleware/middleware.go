@@ -0,0 +1,61 @@
+// Package middleware holds the Gin middleware shared by every service
+// mounted on the marketplace router.
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// CORS builds the CORS policy shared by every service.
+func CORS() gin.HandlerFunc {
+	return cors.New(cors.Config{
+		AllowOrigins:     []string{"http://localhost:3000"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type"},
+		ExposeHeaders:    []string{"Content-Length"},
+		AllowCredentials: true,
+		MaxAge:           12 * time.Hour,
+	})
+}
+
+// RequestID stamps every request with a unique ID, reusing one supplied by
+// the client, and echoes it back on the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+		c.Set(requestIDHeader, id)
+		c.Header(requestIDHeader, id)
+		c.Next()
+	}
+}
+
+// Logger writes one structured log line per request.
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		log.Printf("request_id=%s method=%s path=%s status=%d latency=%s",
+			c.GetString(requestIDHeader), c.Request.Method, c.Request.URL.Path,
+			c.Writer.Status(), time.Since(start))
+	}
+}
+
+func newRequestID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
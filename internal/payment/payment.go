@@ -0,0 +1,65 @@
+// Package payment handles the payment form submitted alongside a
+// transaction, for whichever payment method the client chose.
+package payment
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Form is the card-payment form submitted by the client.
+type Form struct {
+	CardNumber     string `json:"cardNumber"`
+	ExpirationDate string `json:"expirationDate"`
+	CVV            string `json:"cvv"`
+	Name           string `json:"name"`
+	Address        string `json:"address"`
+}
+
+// Request is the body of POST /process-payment. Method selects which
+// payment method the transaction used; Card only applies to "card". Card
+// payments rely on fields of this form and lightning payments are settled
+// out-of-band by the LND invoice, so Method is the only field this endpoint
+// needs from a lightning client.
+type Request struct {
+	Method string `json:"method"`
+	Card   *Form  `json:"card,omitempty"`
+}
+
+// Handler exposes the payment-processing endpoint.
+type Handler struct{}
+
+// NewHandler builds a Handler.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// Register mounts the payment routes on router.
+func (h *Handler) Register(router gin.IRouter) {
+	router.POST("/process-payment", h.process)
+}
+
+func (h *Handler) process(c *gin.Context) {
+	var req Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	switch req.Method {
+	case "", "card":
+		if req.Card == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "card details are required for card payments"})
+			return
+		}
+	case "lightning":
+		// Settlement is handled by the LND invoice the transaction service
+		// already issued; there's nothing further to validate here.
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unsupported payment method"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
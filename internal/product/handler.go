@@ -0,0 +1,93 @@
+package product
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/user"
+)
+
+// Importer fetches a product from an external marketplace URL. It's
+// satisfied by *shop.Manager; the interface lives here instead so this
+// package doesn't need to import internal/shop.
+type Importer interface {
+	Import(ctx context.Context, url string) (Product, error)
+}
+
+// Handler exposes the product catalog over HTTP on top of a Repository.
+type Handler struct {
+	repo     *Repository
+	importer Importer
+	tokens   *user.TokenService
+}
+
+// NewHandler builds a Handler backed by repo. importer may be nil, in which
+// case POST /products/import is rejected. tokens authenticates the two
+// write routes; the catalog listing stays public.
+func NewHandler(repo *Repository, importer Importer, tokens *user.TokenService) *Handler {
+	return &Handler{repo: repo, importer: importer, tokens: tokens}
+}
+
+// Register mounts the product routes on router.
+func (h *Handler) Register(router gin.IRouter) {
+	auth := user.Auth(h.tokens)
+	router.GET("/products", h.list)
+	router.POST("/products", auth, h.create)
+	router.POST("/products/import", auth, h.importProduct)
+}
+
+func (h *Handler) list(c *gin.Context) {
+	products, err := h.repo.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, products)
+}
+
+func (h *Handler) create(c *gin.Context) {
+	var p Product
+	if err := c.BindJSON(&p); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.repo.Create(c.Request.Context(), p)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, created)
+}
+
+func (h *Handler) importProduct(c *gin.Context) {
+	if h.importer == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product import is not configured"})
+		return
+	}
+
+	var body struct {
+		URL string `json:"url" binding:"required"`
+	}
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	imported, err := h.importer.Import(c.Request.Context(), body.URL)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.repo.Create(c.Request.Context(), imported)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, created)
+}
@@ -0,0 +1,17 @@
+// Package product holds the product catalog model, its Mongo-backed
+// repository, and the HTTP handlers that expose it.
+package product
+
+// Product is a single catalog entry. A product created directly via
+// POST /products leaves SourceURL, ImageURL, Currency and ExternalID empty;
+// they're populated when the product was imported from an external
+// marketplace instead (see internal/shop).
+type Product struct {
+	ID         string  `json:"id,omitempty" bson:"_id,omitempty"`
+	Name       string  `json:"name"`
+	Price      float64 `json:"price"`
+	SourceURL  string  `json:"sourceUrl,omitempty" bson:"sourceUrl,omitempty"`
+	ImageURL   string  `json:"imageUrl,omitempty" bson:"imageUrl,omitempty"`
+	Currency   string  `json:"currency,omitempty" bson:"currency,omitempty"`
+	ExternalID string  `json:"externalId,omitempty" bson:"externalId,omitempty"`
+}
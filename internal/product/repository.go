@@ -0,0 +1,55 @@
+package product
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Repository wraps the Mongo collection backing the product catalog.
+type Repository struct {
+	collection *mongo.Collection
+}
+
+// NewRepository builds a Repository around an existing collection handle.
+func NewRepository(collection *mongo.Collection) *Repository {
+	return &Repository{collection: collection}
+}
+
+// List returns every product in the catalog.
+func (r *Repository) List(ctx context.Context) ([]Product, error) {
+	cursor, err := r.collection.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var products []Product
+	for cursor.Next(ctx) {
+		var p Product
+		if err := cursor.Decode(&p); err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+	return products, nil
+}
+
+// Create inserts a new product and returns it as stored, with its
+// generated ID.
+func (r *Repository) Create(ctx context.Context, p Product) (Product, error) {
+	res, err := r.collection.InsertOne(ctx, p)
+	if err != nil {
+		return Product{}, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return Product{}, fmt.Errorf("unexpected inserted ID type %T", res.InsertedID)
+	}
+	p.ID = oid.Hex()
+	return p, nil
+}
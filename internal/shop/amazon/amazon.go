@@ -0,0 +1,91 @@
+// Package amazon implements a shop.ShopAdapter that scrapes a product's
+// title, price and image off its Amazon listing page.
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+)
+
+var (
+	titlePattern = regexp.MustCompile(`id="productTitle"[^>]*>\s*([^<]+?)\s*<`)
+	pricePattern = regexp.MustCompile(`class="a-price-whole">([\d,]+)`)
+	imagePattern = regexp.MustCompile(`id="landingImage"[^>]*data-old-hires="([^"]+)"`)
+	asinPattern  = regexp.MustCompile(`/([A-Z0-9]{10})(?:[/?]|$)`)
+)
+
+// Adapter scrapes product listings from amazon.com and amazon.co.uk. Other
+// regional Amazon storefronts are not registered.
+type Adapter struct {
+	httpClient *http.Client
+}
+
+// New builds an Adapter with a default HTTP timeout.
+func New() *Adapter {
+	return &Adapter{httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Domains reports the Amazon storefronts this adapter knows how to scrape.
+func (a *Adapter) Domains() []string {
+	return []string{"amazon.com", "www.amazon.com", "amazon.co.uk", "www.amazon.co.uk"}
+}
+
+// Get fetches the listing page at rawURL and extracts its title, price and
+// primary image.
+func (a *Adapter) Get(ctx context.Context, rawURL string) (product.Product, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return product.Product{}, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; MarketplaceImportBot/1.0)")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return product.Product{}, fmt.Errorf("fetching amazon listing: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return product.Product{}, fmt.Errorf("amazon listing returned %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return product.Product{}, err
+	}
+	html := string(body)
+
+	title := firstMatch(titlePattern, html)
+	if title == "" {
+		return product.Product{}, fmt.Errorf("could not find product title on %s", rawURL)
+	}
+
+	price, err := strconv.ParseFloat(strings.ReplaceAll(firstMatch(pricePattern, html), ",", ""), 64)
+	if err != nil {
+		return product.Product{}, fmt.Errorf("could not find product price on %s", rawURL)
+	}
+
+	return product.Product{
+		Name:       title,
+		Price:      price,
+		ImageURL:   firstMatch(imagePattern, html),
+		Currency:   "USD",
+		ExternalID: firstMatch(asinPattern, rawURL),
+	}, nil
+}
+
+func firstMatch(pattern *regexp.Regexp, s string) string {
+	m := pattern.FindStringSubmatch(s)
+	if len(m) < 2 {
+		return ""
+	}
+	return strings.TrimSpace(m[1])
+}
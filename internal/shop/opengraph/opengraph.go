@@ -0,0 +1,98 @@
+// Package opengraph implements a shop.ShopAdapter that reads the
+// og:title, og:image and product:price:amount meta tags off any page. It's
+// meant to be registered as the Manager's fallback for domains without a
+// dedicated adapter.
+package opengraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+)
+
+func metaPattern(property string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)<meta[^>]+property="` + property + `"[^>]+content="([^"]*)"`)
+}
+
+var (
+	titleTag    = metaPattern("og:title")
+	imageTag    = metaPattern("og:image")
+	priceTag    = metaPattern("product:price:amount")
+	currencyTag = metaPattern("product:price:currency")
+)
+
+// Adapter reads Open Graph / Facebook product meta tags off any page. It
+// has no Domains of its own; register it with Manager.SetFallback instead
+// of Register so it only handles URLs no dedicated adapter claims.
+type Adapter struct {
+	httpClient *http.Client
+}
+
+// New builds an Adapter with a default HTTP timeout.
+func New() *Adapter {
+	return &Adapter{httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Domains returns nil: the Open Graph adapter is registered as a fallback,
+// not for any specific domain.
+func (a *Adapter) Domains() []string {
+	return nil
+}
+
+// Get fetches rawURL and extracts its Open Graph product metadata.
+func (a *Adapter) Get(ctx context.Context, rawURL string) (product.Product, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return product.Product{}, err
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return product.Product{}, fmt.Errorf("fetching page: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return product.Product{}, fmt.Errorf("page returned %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return product.Product{}, err
+	}
+	html := string(body)
+
+	title := firstMatch(titleTag, html)
+	if title == "" {
+		return product.Product{}, fmt.Errorf("no og:title found on %s", rawURL)
+	}
+
+	currency := firstMatch(currencyTag, html)
+	if currency == "" {
+		currency = "USD"
+	}
+
+	price, _ := strconv.ParseFloat(firstMatch(priceTag, html), 64)
+
+	return product.Product{
+		Name:     title,
+		Price:    price,
+		ImageURL: firstMatch(imageTag, html),
+		Currency: strings.ToUpper(currency),
+	}, nil
+}
+
+func firstMatch(pattern *regexp.Regexp, s string) string {
+	m := pattern.FindStringSubmatch(s)
+	if len(m) < 2 {
+		return ""
+	}
+	return m[1]
+}
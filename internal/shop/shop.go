@@ -0,0 +1,213 @@
+// Package shop lets products be imported from external marketplaces. A
+// ShopAdapter knows how to turn a URL on one or more domains into a
+// product.Product; Manager dispatches an incoming URL to the adapter that
+// owns its domain, rate-limiting and caching requests per domain so a burst
+// of imports doesn't hammer the upstream site.
+package shop
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+)
+
+// ShopAdapter fetches a single product from an external marketplace.
+type ShopAdapter interface {
+	// Domains lists the hostnames this adapter handles, e.g. "amazon.com".
+	Domains() []string
+	// Get fetches and normalizes the product at url.
+	Get(ctx context.Context, rawURL string) (product.Product, error)
+}
+
+// Manager dispatches import requests to the registered ShopAdapter for a
+// URL's domain, rate-limiting and caching responses per domain.
+type Manager struct {
+	adapters map[string]ShopAdapter
+	fallback ShopAdapter
+	cache    *responseCache
+	limiters *limiterSet
+}
+
+// NewManager builds an empty Manager. Adapters are registered with
+// Register, each call's requests per domain are throttled to at most one
+// per minRequestInterval, and successful responses are cached for ttl.
+func NewManager(minRequestInterval, ttl time.Duration) *Manager {
+	return &Manager{
+		adapters: make(map[string]ShopAdapter),
+		cache:    newResponseCache(ttl),
+		limiters: newLimiterSet(minRequestInterval),
+	}
+}
+
+// Register adds adapter for every domain it declares, overwriting any
+// adapter already registered for that domain. It should be called once at
+// startup for each known adapter.
+func (m *Manager) Register(adapter ShopAdapter) {
+	for _, domain := range adapter.Domains() {
+		m.adapters[domain] = adapter
+	}
+}
+
+// SetFallback registers adapter as the one to use for any domain without a
+// dedicated adapter, e.g. a generic OpenGraph scraper.
+func (m *Manager) SetFallback(adapter ShopAdapter) {
+	m.fallback = adapter
+}
+
+// Import fetches the product at rawURL through the adapter registered for
+// its domain, falling back to the fallback adapter if none is registered.
+func (m *Manager) Import(ctx context.Context, rawURL string) (product.Product, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return product.Product{}, fmt.Errorf("parsing url: %w", err)
+	}
+
+	if err := checkPublicHTTPURL(ctx, parsed); err != nil {
+		return product.Product{}, fmt.Errorf("rejecting import url: %w", err)
+	}
+
+	domain := parsed.Hostname()
+	adapter, ok := m.adapters[domain]
+	if !ok {
+		adapter = m.fallback
+	}
+	if adapter == nil {
+		return product.Product{}, fmt.Errorf("no shop adapter registered for %s", domain)
+	}
+
+	if cached, ok := m.cache.get(rawURL); ok {
+		return cached, nil
+	}
+
+	if err := m.limiters.wait(ctx, domain); err != nil {
+		return product.Product{}, err
+	}
+
+	p, err := adapter.Get(ctx, rawURL)
+	if err != nil {
+		return product.Product{}, err
+	}
+	p.SourceURL = rawURL
+
+	m.cache.put(rawURL, p)
+	return p, nil
+}
+
+// checkPublicHTTPURL rejects any URL that could be used to make this
+// server's adapters issue a request against itself or its private network
+// (SSRF): non-http(s) schemes, and hostnames that resolve to a loopback,
+// link-local, private or otherwise unspecified address. It's applied to
+// every import regardless of which adapter ends up handling it, since a
+// dedicated adapter is just as capable of fetching an internal address as
+// the OpenGraph fallback is.
+func checkPublicHTTPURL(ctx context.Context, parsed *url.URL) error {
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", parsed.Scheme)
+	}
+
+	host := parsed.Hostname()
+	if host == "" {
+		return fmt.Errorf("url has no host")
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("resolving host: %w", err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("host %s did not resolve to any address", host)
+	}
+
+	for _, addr := range addrs {
+		if !addr.IP.IsGlobalUnicast() || isPrivateOrReserved(addr.IP) {
+			return fmt.Errorf("host %s resolves to a non-public address %s", host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// isPrivateOrReserved reports whether ip is in a loopback, link-local,
+// private or otherwise non-internet-routable range.
+func isPrivateOrReserved(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsMulticast()
+}
+
+// responseCache remembers successful imports for a short time so re-imports
+// of the same URL don't trigger another upstream request.
+type responseCache struct {
+	ttl   time.Duration
+	mu    sync.Mutex
+	items map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	product  product.Product
+	cachedAt time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{ttl: ttl, items: make(map[string]cacheEntry)}
+}
+
+func (c *responseCache) get(key string) (product.Product, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.items[key]
+	if !ok || time.Since(entry.cachedAt) > c.ttl {
+		return product.Product{}, false
+	}
+	return entry.product, true
+}
+
+func (c *responseCache) put(key string, p product.Product) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = cacheEntry{product: p, cachedAt: time.Now()}
+}
+
+// limiterSet enforces a minimum interval between requests to the same
+// domain, independent of the interval enforced for any other domain.
+type limiterSet struct {
+	interval time.Duration
+	mu       sync.Mutex
+	lastHit  map[string]time.Time
+}
+
+func newLimiterSet(interval time.Duration) *limiterSet {
+	return &limiterSet{interval: interval, lastHit: make(map[string]time.Time)}
+}
+
+func (l *limiterSet) wait(ctx context.Context, domain string) error {
+	l.mu.Lock()
+	last, ok := l.lastHit[domain]
+	wait := time.Duration(0)
+	if ok {
+		if elapsed := time.Since(last); elapsed < l.interval {
+			wait = l.interval - elapsed
+		}
+	}
+	l.lastHit[domain] = time.Now().Add(wait)
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
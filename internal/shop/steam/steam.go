@@ -0,0 +1,101 @@
+// Package steam implements a shop.ShopAdapter that reads a game's listing
+// off Steam's public storefront JSON API.
+package steam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+)
+
+var appIDPattern = regexp.MustCompile(`/app/(\d+)`)
+
+// appDetailsResponse mirrors the subset of store.steampowered.com's
+// appdetails payload this adapter needs.
+type appDetailsResponse map[string]struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Name          string `json:"name"`
+		SteamAppID    int    `json:"steam_appid"`
+		HeaderImage   string `json:"header_image"`
+		PriceOverview struct {
+			FinalFormatted string `json:"final_formatted"`
+			Final          int    `json:"final"`
+			Currency       string `json:"currency"`
+		} `json:"price_overview"`
+		IsFree bool `json:"is_free"`
+	} `json:"data"`
+}
+
+// Adapter reads game listings from the Steam storefront API.
+type Adapter struct {
+	httpClient *http.Client
+}
+
+// New builds an Adapter with a default HTTP timeout.
+func New() *Adapter {
+	return &Adapter{httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Domains reports the Steam storefront host this adapter knows how to call.
+func (a *Adapter) Domains() []string {
+	return []string{"store.steampowered.com"}
+}
+
+// Get looks up the app ID in rawURL against Steam's appdetails API and
+// normalizes the result.
+func (a *Adapter) Get(ctx context.Context, rawURL string) (product.Product, error) {
+	m := appIDPattern.FindStringSubmatch(rawURL)
+	if len(m) < 2 {
+		return product.Product{}, fmt.Errorf("could not find a steam app id in %s", rawURL)
+	}
+	appID := m[1]
+
+	apiURL := "https://store.steampowered.com/api/appdetails?appids=" + appID
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return product.Product{}, err
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return product.Product{}, fmt.Errorf("fetching steam appdetails: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return product.Product{}, fmt.Errorf("steam appdetails returned %d", resp.StatusCode)
+	}
+
+	var parsed appDetailsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return product.Product{}, fmt.Errorf("decoding steam appdetails: %w", err)
+	}
+
+	entry, ok := parsed[appID]
+	if !ok || !entry.Success {
+		return product.Product{}, fmt.Errorf("steam appdetails has no entry for app %s", appID)
+	}
+
+	price := 0.0
+	currency := "USD"
+	if !entry.Data.IsFree {
+		price = float64(entry.Data.PriceOverview.Final) / 100
+		currency = strings.ToUpper(entry.Data.PriceOverview.Currency)
+	}
+
+	return product.Product{
+		Name:       entry.Data.Name,
+		Price:      price,
+		ImageURL:   entry.Data.HeaderImage,
+		Currency:   currency,
+		ExternalID: strconv.Itoa(entry.Data.SteamAppID),
+	}, nil
+}
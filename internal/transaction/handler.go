@@ -0,0 +1,182 @@
+package transaction
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/invoice"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/invoiceclient"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/lightning"
+	"github.com/kfcboxmaster/GolangMarketplace/internal/user"
+)
+
+// Handler exposes the transaction endpoints on top of a Repository.
+type Handler struct {
+	repo       *Repository
+	invoices   *invoiceclient.Client
+	receiptDir string
+	lightning  *lightning.Client
+	tokens     *user.TokenService
+}
+
+// NewHandler builds a Handler backed by repo. Receipts are rendered by
+// calling out to the invoice service via invoices and cached under
+// receiptDir/<transactionID>.pdf. lightningClient may be nil, in which case
+// "lightning" is rejected as a payment method. tokens authenticates
+// create-transaction and the customer's own transaction list.
+func NewHandler(repo *Repository, invoices *invoiceclient.Client, receiptDir string, lightningClient *lightning.Client, tokens *user.TokenService) *Handler {
+	return &Handler{repo: repo, invoices: invoices, receiptDir: receiptDir, lightning: lightningClient, tokens: tokens}
+}
+
+// Register mounts the transaction routes on router.
+func (h *Handler) Register(router gin.IRouter) {
+	auth := user.Auth(h.tokens)
+	router.POST("/create-transaction", auth, h.create)
+	router.GET("/transactions", auth, h.list)
+	router.GET("/transactions/:id/receipt", auth, h.receipt)
+	router.GET("/pay/:TransactionId", auth, h.pay)
+	router.GET("/invoice/:hash", auth, h.invoiceStatus)
+}
+
+func (h *Handler) create(c *gin.Context) {
+	var t Transaction
+	if err := c.BindJSON(&t); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	id, name, email := user.Identity(c)
+	t.Type = "cart"
+	t.Customer = Customer{ID: id, Name: name, Email: email}
+	t.TotalPrice = t.CalculateTotalPrice()
+	t.Status = "awaiting payment"
+	t.CreatedAt = time.Now()
+	t.UpdatedAt = time.Now()
+
+	if t.PaymentMethod == "lightning" {
+		if h.lightning == nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "lightning payments are not configured"})
+			return
+		}
+
+		inv, err := h.lightning.CreateInvoice(c.Request.Context(), t.TotalPrice, "marketplace order")
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			return
+		}
+		t.PaymentHash = &inv.PaymentHash
+		t.PaymentRequest = &inv.PaymentRequest
+	}
+
+	id, err := h.repo.Insert(c.Request.Context(), t)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	t.ID = id
+
+	go h.renderReceipt(t)
+
+	c.JSON(http.StatusOK, gin.H{"transaction": t})
+}
+
+// renderReceipt asks the invoice service to render t's receipt and caches it
+// on disk. It runs in its own goroutine so PDF rendering latency never
+// blocks the create-transaction response.
+func (h *Handler) renderReceipt(t Transaction) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req := invoice.Request{
+		TransactionID: t.ID,
+		CartItems:     t.CartItems,
+		Customer:      invoice.Customer(t.Customer),
+		TotalPrice:    t.TotalPrice,
+	}
+
+	pdf, err := h.invoices.Render(ctx, req)
+	if err != nil {
+		log.Printf("receipt render failed for transaction %s: %v", t.ID, err)
+		return
+	}
+
+	if err := h.SaveReceipt(t.ID, pdf); err != nil {
+		log.Printf("failed to write receipt for transaction %s: %v", t.ID, err)
+	}
+}
+
+// SaveReceipt writes a rendered receipt to disk under its transaction ID.
+// It's exported so invoiceclient.FailureQueue.Drain can use the same
+// receiptDir convention when it recovers a previously failed render.
+func (h *Handler) SaveReceipt(transactionID string, pdf []byte) error {
+	if err := os.MkdirAll(h.receiptDir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(h.receiptPath(transactionID), pdf, 0o644)
+}
+
+func (h *Handler) receiptPath(id string) string {
+	return filepath.Join(h.receiptDir, id+".pdf")
+}
+
+func (h *Handler) list(c *gin.Context) {
+	transactions, err := h.repo.FindByCustomer(c.Request.Context(), user.UserID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, transactions)
+}
+
+func (h *Handler) receipt(c *gin.Context) {
+	id := c.Param("id")
+	t, err := h.repo.FindByID(c.Request.Context(), id)
+	if err != nil || t.Customer.ID != user.UserID(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "receipt not ready"})
+		return
+	}
+
+	path := h.receiptPath(id)
+	if _, err := os.Stat(path); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "receipt not ready"})
+		return
+	}
+	c.File(path)
+}
+
+func (h *Handler) pay(c *gin.Context) {
+	id := c.Param("TransactionId")
+	t, err := h.repo.FindByID(c.Request.Context(), id)
+	if err != nil || t.Customer.ID != user.UserID(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "transaction not found"})
+		return
+	}
+
+	updated, err := h.repo.MarkPaid(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update transaction status"})
+		return
+	}
+	c.JSON(http.StatusOK, updated)
+}
+
+// invoiceStatus reports a lightning invoice's current settlement status. It
+// answers immediately and does not wait for the invoice to settle; the
+// client polls it repeatedly until the status turns "paid".
+func (h *Handler) invoiceStatus(c *gin.Context) {
+	t, err := h.repo.FindByPaymentHash(c.Request.Context(), c.Param("hash"))
+	if err != nil || t.Customer.ID != user.UserID(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "invoice not found"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status":    t.Status,
+		"settledAt": t.SettledAt,
+	})
+}
@@ -0,0 +1,58 @@
+// Package transaction holds the transaction model, its Mongo-backed
+// repository, and the HTTP handlers that create and look up transactions.
+package transaction
+
+import (
+	"time"
+
+	"github.com/kfcboxmaster/GolangMarketplace/internal/product"
+)
+
+// Customer identifies who a transaction belongs to.
+type Customer struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// BillDetails identifies the vendor bill a "bill" transaction paid. It's
+// only populated when Type is "bill".
+type BillDetails struct {
+	VendorID        string `json:"vendorId" bson:"vendorId"`
+	ProductID       string `json:"productId" bson:"productId"`
+	CustomerRef     string `json:"customerRef" bson:"customerRef"`
+	VendorReference string `json:"vendorReference,omitempty" bson:"vendorReference,omitempty"`
+}
+
+// Transaction is a single checkout: the cart, the customer, and its status.
+// Type discriminates between a cart-based checkout and a bill payment, since
+// both live in the same collection.
+type Transaction struct {
+	ID         string            `json:"id" bson:"_id,omitempty"`
+	Type       string            `json:"type"` // "cart" (default) or "bill"
+	CartItems  []product.Product `json:"cartItems,omitempty"`
+	Customer   Customer          `json:"customer"`
+	Status     string            `json:"status"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+	TotalPrice float64           `json:"totalPrice"`
+
+	// BillDetails only applies to Type "bill".
+	BillDetails *BillDetails `json:"billDetails,omitempty" bson:"billDetails,omitempty"`
+
+	// PaymentMethod selects how the transaction is settled: "card" (the
+	// default) or "lightning". The fields below only apply to the latter.
+	PaymentMethod  string     `json:"paymentMethod,omitempty"`
+	PaymentHash    *string    `json:"paymentHash,omitempty" bson:"paymentHash,omitempty"`
+	PaymentRequest *string    `json:"paymentRequest,omitempty" bson:"paymentRequest,omitempty"`
+	SettledAt      *time.Time `json:"settledAt,omitempty" bson:"settledAt,omitempty"`
+}
+
+// CalculateTotalPrice sums the price of every cart item.
+func (t Transaction) CalculateTotalPrice() float64 {
+	var total float64
+	for _, item := range t.CartItems {
+		total += item.Price
+	}
+	return total
+}
@@ -0,0 +1,161 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository wraps the Mongo collection backing transactions.
+type Repository struct {
+	collection *mongo.Collection
+}
+
+// NewRepository builds a Repository around an existing collection handle.
+func NewRepository(collection *mongo.Collection) *Repository {
+	return &Repository{collection: collection}
+}
+
+// Insert stores a new transaction and returns its generated ID.
+func (r *Repository) Insert(ctx context.Context, t Transaction) (string, error) {
+	res, err := r.collection.InsertOne(ctx, t)
+	if err != nil {
+		return "", err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("unexpected inserted ID type %T", res.InsertedID)
+	}
+	return oid.Hex(), nil
+}
+
+// FindByID looks up a single transaction by its ID.
+func (r *Repository) FindByID(ctx context.Context, id string) (Transaction, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	var t Transaction
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&t)
+	return t, err
+}
+
+// FindByCustomer returns every transaction belonging to customerID.
+func (r *Repository) FindByCustomer(ctx context.Context, customerID string) ([]Transaction, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"customer.id": customerID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var transactions []Transaction
+	for cursor.Next(ctx) {
+		var t Transaction
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+	return transactions, nil
+}
+
+// MarkPaid flips a transaction's status to "paid" and returns the updated
+// document.
+func (r *Repository) MarkPaid(ctx context.Context, id string) (Transaction, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":    "paid",
+			"updatedAt": time.Now(),
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated Transaction
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	return updated, err
+}
+
+// CompleteBill marks a "bill" transaction paid and records the vendor's own
+// reference for the payment, once BillProvider.Pay has confirmed it.
+func (r *Repository) CompleteBill(ctx context.Context, id, vendorReference string) (Transaction, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":                      "paid",
+			"updatedAt":                   time.Now(),
+			"billDetails.vendorReference": vendorReference,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated Transaction
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	return updated, err
+}
+
+// MarkFailed flips a transaction's status to "failed", e.g. when a bill
+// vendor rejects the payment.
+func (r *Repository) MarkFailed(ctx context.Context, id string) (Transaction, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	filter := bson.M{"_id": objectID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":    "failed",
+			"updatedAt": time.Now(),
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated Transaction
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	return updated, err
+}
+
+// FindByPaymentHash looks up the transaction awaiting settlement of a
+// lightning invoice.
+func (r *Repository) FindByPaymentHash(ctx context.Context, hash string) (Transaction, error) {
+	var t Transaction
+	err := r.collection.FindOne(ctx, bson.M{"paymentHash": hash}).Decode(&t)
+	return t, err
+}
+
+// SettleByPaymentHash flips a transaction's status to "paid" and stamps
+// SettledAt once its lightning invoice has settled.
+func (r *Repository) SettleByPaymentHash(ctx context.Context, hash string) (Transaction, error) {
+	now := time.Now()
+	filter := bson.M{"paymentHash": hash}
+	update := bson.M{
+		"$set": bson.M{
+			"status":    "paid",
+			"updatedAt": now,
+			"settledAt": now,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated Transaction
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	return updated, err
+}
@@ -0,0 +1,193 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const refreshTokenTTL = 30 * 24 * time.Hour
+
+// Handler exposes account signup/login and the sensitive account routes
+// that sit behind Auth and RequireCurrentPassword.
+type Handler struct {
+	repo   *Repository
+	tokens *TokenService
+}
+
+// NewHandler builds a Handler backed by repo, issuing tokens through
+// tokens.
+func NewHandler(repo *Repository, tokens *TokenService) *Handler {
+	return &Handler{repo: repo, tokens: tokens}
+}
+
+// Register mounts the public auth routes and the authenticated account
+// routes on router.
+func (h *Handler) Register(router gin.IRouter) {
+	router.POST("/signup", h.signup)
+	router.POST("/login", h.login)
+	router.POST("/refresh", h.refresh)
+	router.POST("/logout", h.logout)
+
+	account := router.Group("/account", Auth(h.tokens))
+	account.PATCH("/email", RequireCurrentPassword(h.repo), h.changeEmail)
+	account.DELETE("", RequireCurrentPassword(h.repo), h.deleteAccount)
+}
+
+func (h *Handler) signup(c *gin.Context) {
+	var body struct {
+		Name     string `json:"name" binding:"required"`
+		Email    string `json:"email" binding:"required"`
+		Password string `json:"password" binding:"required"`
+	}
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := h.repo.FindByEmail(c.Request.Context(), body.Email); err == nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "an account with that email already exists"})
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(body.Password), bcrypt.DefaultCost)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	u, err := h.repo.Create(c.Request.Context(), User{
+		Name:         body.Name,
+		Email:        body.Email,
+		PasswordHash: string(hash),
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.issueTokenPair(c, u)
+}
+
+func (h *Handler) login(c *gin.Context) {
+	var body struct {
+		Email    string `json:"email" binding:"required"`
+		Password string `json:"password" binding:"required"`
+	}
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	u, err := h.repo.FindByEmail(c.Request.Context(), body.Email)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid email or password"})
+		return
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(body.Password)) != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid email or password"})
+		return
+	}
+
+	h.issueTokenPair(c, u)
+}
+
+func (h *Handler) refresh(c *gin.Context) {
+	var body struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rt, err := h.repo.FindRefreshToken(c.Request.Context(), body.RefreshToken)
+	if errors.Is(err, ErrNotFound) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or revoked refresh token"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	u, err := h.repo.FindByID(c.Request.Context(), rt.UserID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "account not found"})
+		return
+	}
+
+	accessToken, err := h.tokens.IssueAccessToken(u)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"accessToken": accessToken})
+}
+
+func (h *Handler) logout(c *gin.Context) {
+	var body struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+	if err := c.BindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.repo.RevokeRefreshToken(c.Request.Context(), body.RefreshToken); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func (h *Handler) changeEmail(c *gin.Context) {
+	var body struct {
+		Email string `json:"email" binding:"required"`
+	}
+	if err := c.ShouldBindBodyWith(&body, binding.JSON); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.repo.UpdateEmail(c.Request.Context(), UserID(c), body.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func (h *Handler) deleteAccount(c *gin.Context) {
+	if err := h.repo.Delete(c.Request.Context(), UserID(c)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// issueTokenPair signs a fresh access token and issues a server-side
+// refresh token for u, and writes both to the response.
+func (h *Handler) issueTokenPair(c *gin.Context, u User) {
+	accessToken, err := h.tokens.IssueAccessToken(u)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	refreshToken, err := h.repo.IssueRefreshToken(c.Request.Context(), u.ID, refreshTokenTTL)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"accessToken":  accessToken,
+		"refreshToken": refreshToken,
+		"user":         gin.H{"id": u.ID, "name": u.Name, "email": u.Email},
+	})
+}
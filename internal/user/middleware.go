@@ -0,0 +1,84 @@
+package user
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	userIDKey = "user.id"
+	nameKey   = "user.name"
+	emailKey  = "user.email"
+)
+
+// Auth validates the bearer access token on every request and injects the
+// authenticated user's ID, name and email into the Gin context, so
+// downstream handlers never need to trust a customer ID supplied by the
+// client.
+func Auth(tokens *TokenService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		raw, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || raw == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+
+		claims, err := tokens.Verify(raw)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
+			return
+		}
+
+		c.Set(userIDKey, claims.Subject)
+		c.Set(nameKey, claims.Name)
+		c.Set(emailKey, claims.Email)
+		c.Next()
+	}
+}
+
+// UserID returns the authenticated user's ID stamped by Auth. It must only
+// be called on a route behind Auth.
+func UserID(c *gin.Context) string {
+	return c.GetString(userIDKey)
+}
+
+// Identity returns the authenticated user's ID, name and email stamped by
+// Auth. It must only be called on a route behind Auth.
+func Identity(c *gin.Context) (id, name, email string) {
+	return c.GetString(userIDKey), c.GetString(nameKey), c.GetString(emailKey)
+}
+
+// RequireCurrentPassword re-checks the account's password before a
+// destructive operation (account deletion, email change, refund), so a
+// stolen access token alone isn't enough to perform it. It must be mounted
+// behind Auth, and expects the request body to include a "currentPassword"
+// field alongside whatever else the route needs.
+func RequireCurrentPassword(repo *Repository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			CurrentPassword string `json:"currentPassword" binding:"required"`
+		}
+		if err := c.ShouldBindBodyWith(&body, binding.JSON); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "currentPassword is required"})
+			return
+		}
+
+		u, err := repo.FindByID(c.Request.Context(), UserID(c))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "account not found"})
+			return
+		}
+
+		if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(body.CurrentPassword)) != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "current password is incorrect"})
+			return
+		}
+
+		c.Next()
+	}
+}
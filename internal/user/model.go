@@ -0,0 +1,25 @@
+// Package user holds account signup/login, the JWT access/refresh token
+// pair, and the auth middleware that the rest of the marketplace relies on
+// to know who's making a request.
+package user
+
+import "time"
+
+// User is an account that can authenticate and own transactions.
+type User struct {
+	ID           string    `json:"id" bson:"_id,omitempty"`
+	Name         string    `json:"name" bson:"name"`
+	Email        string    `json:"email" bson:"email"`
+	PasswordHash string    `json:"-" bson:"passwordHash"`
+	CreatedAt    time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+// RefreshToken is a server-side record of an issued refresh token, so it
+// can be revoked (on logout, or if compromised) independently of its
+// expiry.
+type RefreshToken struct {
+	ID        string    `bson:"_id"`
+	UserID    string    `bson:"userId"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+	Revoked   bool      `bson:"revoked"`
+}
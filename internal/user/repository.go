@@ -0,0 +1,142 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrNotFound is returned when a lookup by email or ID matches no account.
+var ErrNotFound = errors.New("user not found")
+
+// Repository wraps the Mongo collections backing accounts and their
+// refresh tokens.
+type Repository struct {
+	users         *mongo.Collection
+	refreshTokens *mongo.Collection
+}
+
+// NewRepository builds a Repository around the given collections.
+func NewRepository(users, refreshTokens *mongo.Collection) *Repository {
+	return &Repository{users: users, refreshTokens: refreshTokens}
+}
+
+// Create inserts a new account and returns it with its generated ID.
+func (r *Repository) Create(ctx context.Context, u User) (User, error) {
+	res, err := r.users.InsertOne(ctx, u)
+	if err != nil {
+		return User{}, err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return User{}, fmt.Errorf("unexpected inserted ID type %T", res.InsertedID)
+	}
+	u.ID = oid.Hex()
+	return u, nil
+}
+
+// FindByEmail looks up an account by its email address.
+func (r *Repository) FindByEmail(ctx context.Context, email string) (User, error) {
+	var u User
+	err := r.users.FindOne(ctx, bson.M{"email": email}).Decode(&u)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return User{}, ErrNotFound
+	}
+	return u, err
+}
+
+// FindByID looks up an account by its ID.
+func (r *Repository) FindByID(ctx context.Context, id string) (User, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return User{}, ErrNotFound
+	}
+
+	var u User
+	err = r.users.FindOne(ctx, bson.M{"_id": objectID}).Decode(&u)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return User{}, ErrNotFound
+	}
+	return u, err
+}
+
+// UpdateEmail changes the email address on file for userID.
+func (r *Repository) UpdateEmail(ctx context.Context, userID, email string) error {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return ErrNotFound
+	}
+
+	_, err = r.users.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": bson.M{"email": email}})
+	return err
+}
+
+// Delete removes the account for userID.
+func (r *Repository) Delete(ctx context.Context, userID string) error {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return ErrNotFound
+	}
+
+	_, err = r.users.DeleteOne(ctx, bson.M{"_id": objectID})
+	return err
+}
+
+// IssueRefreshToken records a new refresh token for userID, expiring after
+// ttl, and returns its opaque value.
+func (r *Repository) IssueRefreshToken(ctx context.Context, userID string, ttl time.Duration) (string, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = r.refreshTokens.InsertOne(ctx, RefreshToken{
+		ID:        token,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// FindRefreshToken looks up a refresh token, returning ErrNotFound if it
+// doesn't exist, is revoked, or has expired.
+func (r *Repository) FindRefreshToken(ctx context.Context, token string) (RefreshToken, error) {
+	var rt RefreshToken
+	err := r.refreshTokens.FindOne(ctx, bson.M{"_id": token}).Decode(&rt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return RefreshToken{}, ErrNotFound
+	}
+	if err != nil {
+		return RefreshToken{}, err
+	}
+	if rt.Revoked || time.Now().After(rt.ExpiresAt) {
+		return RefreshToken{}, ErrNotFound
+	}
+	return rt, nil
+}
+
+// RevokeRefreshToken marks token as revoked so it can no longer be
+// exchanged for an access token.
+func (r *Repository) RevokeRefreshToken(ctx context.Context, token string) error {
+	_, err := r.refreshTokens.UpdateOne(ctx, bson.M{"_id": token}, bson.M{"$set": bson.M{"revoked": true}})
+	return err
+}
+
+func newOpaqueToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
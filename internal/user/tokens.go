@@ -0,0 +1,63 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const accessTokenTTL = 15 * time.Minute
+
+// AccessClaims are the JWT claims carried by an access token. They include
+// enough of the account to stamp a transaction's Customer without a second
+// database round trip.
+type AccessClaims struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// TokenService issues and verifies access tokens signed with a shared
+// secret.
+type TokenService struct {
+	secret []byte
+}
+
+// NewTokenService builds a TokenService around secret, read from the
+// JWT_SECRET environment variable at startup.
+func NewTokenService(secret string) *TokenService {
+	return &TokenService{secret: []byte(secret)}
+}
+
+// IssueAccessToken signs a short-lived access token for u.
+func (s *TokenService) IssueAccessToken(u User) (string, error) {
+	claims := AccessClaims{
+		Name:  u.Name,
+		Email: u.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   u.ID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+// Verify parses and validates a bearer token, returning its claims.
+func (s *TokenService) Verify(raw string) (AccessClaims, error) {
+	var claims AccessClaims
+	token, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return s.secret, nil
+	})
+	if err != nil {
+		return AccessClaims{}, err
+	}
+	if !token.Valid {
+		return AccessClaims{}, fmt.Errorf("invalid access token")
+	}
+	return claims, nil
+}